@@ -0,0 +1,163 @@
+// Package catalog provides pre-built instances of well-known matroids, for
+// use in tests and for validating rank/dual/intersection implementations
+// against known invariants (e.g. the Fano matroid's self-duality, or the
+// Vámos matroid's non-representability over any field).
+package catalog
+
+import (
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/mat"
+
+	"github.com/yuichiro12/matroid-intersection"
+)
+
+// fanoMatrix is the standard 3x7 representation of the Fano plane: its
+// columns are the 7 nonzero vectors of GF(2)^3. Read over GF(2) it
+// represents the Fano matroid F7; read over the rationals, the same matrix
+// represents the non-Fano matroid, since the sole GF(2) dependency among
+// its columns (a XOR b XOR c = 0) does not hold as a rational linear
+// dependency.
+var fanoMatrix = mat.NewDense(3, 7, []float64{
+	1, 0, 0, 1, 1, 0, 1,
+	0, 1, 0, 1, 0, 1, 1,
+	0, 0, 1, 0, 1, 1, 1,
+})
+
+// F7() returns the Fano matroid: rank 3 on 7 elements, representable only
+// over fields of characteristic 2.
+func F7() matroid.Matroid {
+	return matroid.NewLinear(fanoMatrix, matroid.FieldBinary)
+}
+
+// NonFano() returns the non-Fano matroid: rank 3 on 7 elements, the
+// relaxation of F7 obtained by freeing its one dependent line. It is
+// representable over every field except GF(2).
+func NonFano() matroid.Matroid {
+	return matroid.NewLinear(fanoMatrix, matroid.FieldRational)
+}
+
+// U24() returns U(2,4), the uniform matroid of rank 2 on 4 elements: the
+// textbook example of a matroid with no representation over GF(2).
+func U24() matroid.Matroid {
+	return matroid.NewUniform(4, 2)
+}
+
+// K4() returns M(K4), the graphic matroid of the complete graph on 4
+// vertices: rank 3 on 6 elements.
+func K4() matroid.Matroid {
+	return matroid.NewGraphic(completeGraph(4))
+}
+
+// K5() returns M(K5), the graphic matroid of the complete graph on 5
+// vertices: rank 4 on 10 elements. Famous as one of the two forbidden
+// minors (with K3,3) for planarity, so its cographic dual detects
+// non-planarity via HasMinor.
+func K5() matroid.Matroid {
+	return matroid.NewGraphic(completeGraph(5))
+}
+
+// completeGraph() returns Kn, the undirected complete graph on n vertices
+// 0..n-1.
+func completeGraph(n int) *simple.UndirectedGraph {
+	g := simple.NewUndirectedGraph()
+	for i := 0; i < n; i++ {
+		g.AddNode(simple.Node(i))
+	}
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			g.SetEdge(simple.Edge{F: simple.Node(i), T: simple.Node(j)})
+		}
+	}
+	return g
+}
+
+// Vamos() returns the Vámos matroid V8: rank 4 on 8 elements, the textbook
+// example of a matroid representable over no field at all. Its elements
+// are grouped into 4 pairs; a 4-subset is a basis unless it is the union of
+// two of the pairs {0,1}, {2,3}, {4,5}, {6,7} in one of 5 designated
+// "special" combinations (every other 4-subset, including the 6th
+// pair-union, is independent).
+func Vamos() matroid.Matroid {
+	pairs := [4][2]int{{0, 1}, {2, 3}, {4, 5}, {6, 7}}
+	dependentPairUnions := map[[2]int]bool{
+		{0, 1}: true, {0, 2}: true, {0, 3}: true, {1, 2}: true, {1, 3}: true,
+	}
+
+	elems := make([]matroid.Element, 8)
+	for i := range elems {
+		elems[i] = matroid.NewIntElement(i, 0)
+	}
+
+	var bases [][]matroid.Element
+	combinations(8, 4, func(idx []int) {
+		if pi, pj, ok := asPairUnion(idx, pairs); ok && dependentPairUnions[[2]int{pi, pj}] {
+			return
+		}
+		base := make([]matroid.Element, len(idx))
+		for k, i := range idx {
+			base[k] = elems[i]
+		}
+		bases = append(bases, base)
+	})
+
+	return matroid.NewBasisMatroid(elems, bases)
+}
+
+// asPairUnion() reports whether idx is exactly the union of two of pairs,
+// and if so, which two.
+func asPairUnion(idx []int, pairs [4][2]int) (pi, pj int, ok bool) {
+	in := make(map[int]bool, len(idx))
+	for _, i := range idx {
+		in[i] = true
+	}
+	var hit []int
+	for p, pair := range pairs {
+		if in[pair[0]] && in[pair[1]] {
+			hit = append(hit, p)
+		}
+	}
+	if len(hit) == 2 {
+		return hit[0], hit[1], true
+	}
+	return 0, 0, false
+}
+
+// combinations() calls f with every size-k subset of {0, ..., n-1}, each as
+// a slice of indices in increasing order.
+func combinations(n, k int, f func(idx []int)) {
+	idx := make([]int, k)
+	var rec func(start, depth int)
+	rec = func(start, depth int) {
+		if depth == k {
+			f(append([]int(nil), idx...))
+			return
+		}
+		for i := start; i < n; i++ {
+			idx[depth] = i
+			rec(i+1, depth+1)
+		}
+	}
+	rec(0, 0)
+}
+
+// r10Matrix is the standard 5x10 GF(2) representation of R10: the identity
+// I5 alongside J5-I5 (every column of the second half has exactly four
+// 1s). R10 is regular (representable over every field via some TU signed
+// matrix), and is one of the three building blocks (with graphic and
+// cographic matroids) of Seymour's decomposition theorem for regular
+// matroids, but this particular matrix is only totally unimodular over
+// GF(2): read over the rationals, J5-I5 is not TU (its signless entries
+// make columns 5-9 independent instead of the rank-4 circuit R10 actually
+// has there), so it must be read with FieldBinary.
+var r10Matrix = mat.NewDense(5, 10, []float64{
+	1, 0, 0, 0, 0, 0, 1, 1, 1, 1,
+	0, 1, 0, 0, 0, 1, 0, 1, 1, 1,
+	0, 0, 1, 0, 0, 1, 1, 0, 1, 1,
+	0, 0, 0, 1, 0, 1, 1, 1, 0, 1,
+	0, 0, 0, 0, 1, 1, 1, 1, 1, 0,
+})
+
+// R10() returns R10: rank 5 on 10 elements.
+func R10() matroid.Matroid {
+	return matroid.NewLinear(r10Matrix, matroid.FieldBinary)
+}
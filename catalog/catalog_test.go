@@ -0,0 +1,64 @@
+package catalog
+
+import (
+	"testing"
+
+	matroid "github.com/yuichiro12/matroid-intersection"
+)
+
+func TestCatalogRanks(t *testing.T) {
+	tests := []struct {
+		name string
+		m    matroid.Matroid
+		rank int
+	}{
+		{"F7", F7(), 3},
+		{"NonFano", NonFano(), 3},
+		{"U24", U24(), 2},
+		{"K4", K4(), 3},
+		{"K5", K5(), 4},
+		{"Vamos", Vamos(), 4},
+		{"R10", R10(), 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.m.Rank(tt.m.GroundSet()); got != tt.rank {
+				t.Fatalf("%s: Rank(GroundSet()) = %d, want %d", tt.name, got, tt.rank)
+			}
+		})
+	}
+}
+
+// TestR10SelfDual checks the textbook fact that R10 is self-dual: its dual
+// (also rank 5 on 10 elements, since dual rank is always |E|-rank) is
+// isomorphic to itself. HasMinor(R10, Dual(R10)) with equal-size ground
+// sets reduces to a plain isomorphism check (the only minor of the right
+// cardinality is R10 itself, with nothing deleted or contracted).
+//
+// F7 has no such property to check: its dual has rank 7-3=4, not 3, so it
+// cannot possibly be isomorphic to F7 (isomorphism preserves rank).
+func TestR10SelfDual(t *testing.T) {
+	r10 := R10()
+	dual := matroid.Dual(r10)
+	if !matroid.HasMinor(r10, dual) {
+		t.Fatal("R10 should be isomorphic to its own dual")
+	}
+}
+
+// TestR10KnownCircuit checks the fix for the R10 representation: over
+// GF(2), columns 5-9 of r10Matrix (J5-I5) form one of R10's circuits, so
+// they must be dependent with rank 4, not the full 5.
+func TestR10KnownCircuit(t *testing.T) {
+	r10 := R10()
+	elems := r10.GroundSet().ToSlice()
+	sub := matroid.EmptySet(r10.GroundSet().GetType())
+	for _, e := range elems[5:10] {
+		sub.Add(e)
+	}
+	if got, want := r10.Rank(sub), 4; got != want {
+		t.Fatalf("Rank(columns 5-9) = %d, want %d", got, want)
+	}
+	if r10.Independent(sub) {
+		t.Fatal("columns 5-9 should be dependent")
+	}
+}
@@ -0,0 +1,57 @@
+package matroid
+
+import "strconv"
+
+// Element is a member of a matroid's ground set.
+//
+// Key must be unique within a single Set and stable for the lifetime of the
+// element, since Set uses it to index and deduplicate elements.
+type Element interface {
+	// Key() returns a unique identifier for the element within its Set.
+	Key() string
+	// Weight() returns the element's weight. Only weighted operations, such
+	// as GetMaximalBaseOf and the weighted variant of Intersection, consult
+	// it; unweighted callers may return 0 for every element.
+	Weight() float64
+}
+
+// intElement is a plain Element identified by an integer index, for
+// constructors whose ground set has no natural payload of its own (e.g.
+// NewUniform).
+type intElement struct {
+	i int
+	w float64
+}
+
+// NewIntElement() returns an Element identified by i with weight w.
+func NewIntElement(i int, w float64) Element {
+	return intElement{i: i, w: w}
+}
+
+func (e intElement) Key() string {
+	return strconv.Itoa(e.i)
+}
+
+func (e intElement) Weight() float64 {
+	return e.w
+}
+
+// keyedElement is a plain Element identified directly by a string key, for
+// constructors that only have a key to work with, such as Unmarshal.
+type keyedElement struct {
+	k string
+	w float64
+}
+
+// NewKeyedElement() returns an Element identified by k with weight w.
+func NewKeyedElement(k string, w float64) Element {
+	return keyedElement{k: k, w: w}
+}
+
+func (e keyedElement) Key() string {
+	return e.k
+}
+
+func (e keyedElement) Weight() float64 {
+	return e.w
+}
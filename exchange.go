@@ -0,0 +1,222 @@
+package matroid
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+// node is a vertex of the matroid-intersection exchange digraph: one copy
+// of a ground-set element, tagged with which side of the current common
+// independent set S it sits on and its current shortest-path potential (see
+// shortestAugmentingPath).
+type node struct {
+	id        int64
+	key       string
+	weight    float64
+	potential float64
+	inC       bool
+}
+
+func (n node) ID() int64 {
+	return n.id
+}
+
+// weightedEdge is a directed arc of the exchange digraph. Its Weight() is
+// the potential-reduced cost of the exchange it represents: every
+// augmenting path's total raw cost is exactly the net change in S's
+// weight, so the minimum-cost X1->X2 path maximizes the weight gained by
+// that augmentation, and reducing by each endpoint's potential keeps that
+// search valid round over round (see shortestAugmentingPath).
+type weightedEdge struct {
+	tail, head node
+}
+
+func (w weightedEdge) From() graph.Node {
+	return w.tail
+}
+
+func (w weightedEdge) To() graph.Node {
+	return w.head
+}
+
+func (w weightedEdge) ReversedEdge() graph.Edge {
+	return weightedEdge{tail: w.head, head: w.tail}
+}
+
+// rawCost() is -head.weight if head is entering S (it currently sits in C,
+// so traversing this arc gains its weight) or +head.weight if head is
+// leaving S (traversing it gives up that weight).
+func (w weightedEdge) rawCost() float64 {
+	if w.head.inC {
+		return -w.head.weight
+	}
+	return w.head.weight
+}
+
+func (w weightedEdge) Weight() float64 {
+	return w.rawCost() + w.tail.potential - w.head.potential
+}
+
+// generateMatroidIntersectionBipartiteDigraph() builds the exchange digraph
+// for common independent set s against complement c: an arc y->x (y in S, x
+// in C) for every exchange S-y+x independent in m1, and x->y for every
+// exchange S-y+x independent in m2. It also returns the key->node lookup.
+func generateMatroidIntersectionBipartiteDigraph(s, c *Set, m1, m2 Matroid, potential map[string]float64) (*simple.WeightedDirectedGraph, map[string]node) {
+	k2n := getKeyToNodeMap(s, c, potential)
+	d := simple.NewWeightedDirectedGraph(0, math.Inf(1))
+	for _, v := range k2n {
+		d.AddNode(v)
+	}
+	// s0 is mutated in place via Toggle for every (e, f) candidate pair
+	// instead of being re-cloned, so this loop costs O(|S||C|) Independent
+	// calls plus O(1) Set work per pair rather than an allocation apiece.
+	s0 := s.Clone()
+	s.Each(func(e Element) bool {
+		c.Each(func(f Element) bool {
+			s0.Toggle(e)
+			s0.Toggle(f)
+			if m1.Independent(s0) {
+				d.SetWeightedEdge(weightedEdge{tail: k2n[e.Key()], head: k2n[f.Key()]})
+			}
+			if m2.Independent(s0) {
+				d.SetWeightedEdge(weightedEdge{tail: k2n[f.Key()], head: k2n[e.Key()]})
+			}
+			s0.Toggle(f)
+			s0.Toggle(e)
+			return true
+		})
+		return true
+	})
+	return d, k2n
+}
+
+func getKeyToNodeMap(s, c *Set, potential map[string]float64) map[string]node {
+	m := make(map[string]node)
+	var idx int64
+	s.Each(func(e Element) bool {
+		m[e.Key()] = node{id: idx, key: e.Key(), weight: e.Weight(), potential: potential[e.Key()], inC: false}
+		idx++
+		return true
+	})
+	c.Each(func(e Element) bool {
+		m[e.Key()] = node{id: idx, key: e.Key(), weight: e.Weight(), potential: potential[e.Key()], inC: true}
+		idx++
+		return true
+	})
+	return m
+}
+
+const superSourceID = int64(-1)
+
+// shortestAugmentingPath() finds a minimum-cost X1->X2 path in d, breaking
+// ties by fewest arcs (Cassidy/Frank's weighted matroid intersection
+// algorithm). It returns the path as a sequence of element keys (excluding
+// the virtual source), the raw-cost distance to every node reached (which
+// the caller folds into node potentials for the next iteration), and the
+// path's own total raw cost: S's weight changes by exactly -cost if the
+// caller applies it, so a caller solving for maximum weight should stop
+// once cost turns positive rather than apply it.
+//
+// The search runs a Bellman-Ford relaxation (the reduced costs fed in via
+// node.potential are only guaranteed nonnegative once potentials have
+// settled over a few rounds, so plain Dijkstra isn't safe) from a virtual
+// source wired to every X1 node, labelling each node with (distance, hop
+// count) compared lexicographically. The hop-count tie-break matters for
+// correctness, not just aesthetics: when two paths reach the same node at
+// equal cost, only the shorter of the two is guaranteed to be a valid
+// exchange (a longer, equal-cost path can pass through the X1/X2 frontier
+// without ending there, which does not correspond to a real augmentation).
+func shortestAugmentingPath(d *simple.WeightedDirectedGraph, k2n map[string]node, x1, x2 *Set) ([]string, map[string]float64, float64, bool) {
+	key := make(map[int64]string, len(k2n))
+	for k, n := range k2n {
+		key[n.id] = k
+	}
+
+	source := node{id: superSourceID}
+	d.AddNode(source)
+	defer d.RemoveNode(superSourceID)
+	for e := range x1.Iter() {
+		d.SetWeightedEdge(weightedEdge{tail: source, head: k2n[e.Key()]})
+	}
+
+	nodes := d.Nodes()
+	ids := make([]int64, 0, nodes.Len())
+	for nodes.Next() {
+		ids = append(ids, nodes.Node().ID())
+	}
+
+	const inf = math.MaxFloat64
+	dist := make(map[int64]float64, len(ids))
+	hops := make(map[int64]int, len(ids))
+	parent := make(map[int64]int64, len(ids))
+	for _, id := range ids {
+		dist[id] = inf
+	}
+	dist[superSourceID] = 0
+
+	// Bellman-Ford: |V|-1 relaxation rounds suffice for any simple shortest
+	// path in a graph without negative cycles.
+	for i := 0; i < len(ids); i++ {
+		changed := false
+		for _, u := range ids {
+			if dist[u] == inf {
+				continue
+			}
+			to := d.From(u)
+			for to.Next() {
+				v := to.Node().ID()
+				w := d.WeightedEdge(u, v).Weight()
+				nd, nh := dist[u]+w, hops[u]+1
+				if nd < dist[v] || (nd == dist[v] && nh < hops[v]) {
+					dist[v], hops[v], parent[v] = nd, nh, u
+					changed = true
+				}
+			}
+		}
+		if !changed {
+			break
+		}
+	}
+
+	bestID, bestCost, bestHops, found := int64(0), math.Inf(1), 0, false
+	for e := range x2.Iter() {
+		n := k2n[e.Key()]
+		// Reduced-cost distances to different targets aren't directly
+		// comparable: the potential telescopes to pi(source)-pi(target),
+		// which varies by target. Add the target's own potential back to
+		// recover a true, comparable path cost before picking the cheapest,
+		// breaking ties by fewest hops for the same reason the relaxation
+		// above does: among equal-cost paths, only the shortest is
+		// guaranteed to be a valid exchange.
+		d := dist[n.id]
+		if d == inf {
+			continue
+		}
+		cost, h := d+n.potential, hops[n.id]
+		if !found || cost < bestCost || (cost == bestCost && h < bestHops) {
+			bestCost, bestHops, bestID, found = cost, h, n.id, true
+		}
+	}
+	if !found {
+		return nil, nil, 0, false
+	}
+
+	var rev []string
+	for id := bestID; id != superSourceID; id = parent[id] {
+		rev = append(rev, key[id])
+	}
+	keys := make([]string, len(rev))
+	for i, k := range rev {
+		keys[len(rev)-1-i] = k
+	}
+
+	deltas := make(map[string]float64, len(k2n))
+	for k, n := range k2n {
+		if d := dist[n.id]; d < inf {
+			deltas[k] = d
+		}
+	}
+	return keys, deltas, bestCost, true
+}
@@ -0,0 +1,103 @@
+package matroid
+
+import (
+	"fmt"
+
+	"gonum.org/v1/gonum/graph"
+)
+
+// graphicSetType is the SetType shared by every ground set built with
+// NewGraphic: its Elements are the edges of the underlying graph.
+const graphicSetType SetType = "graphic"
+
+// graphEdge is an Element representing one edge of the graph passed to
+// NewGraphic, keyed by its (ordered) endpoint IDs so both matroids built
+// over the same graph agree on element identity.
+type graphEdge struct {
+	uid, vid int64
+	w        float64
+}
+
+func (e graphEdge) Key() string {
+	return fmt.Sprintf("%d-%d", e.uid, e.vid)
+}
+
+func (e graphEdge) Weight() float64 {
+	return e.w
+}
+
+// graphicMatroid is the graphic (cycle) matroid of an undirected graph:
+// independent sets are exactly the forests (acyclic edge subsets).
+type graphicMatroid struct {
+	groundSet *Set
+	endpoints map[string][2]int64
+	vertices  []int64
+}
+
+// NewGraphic() returns the graphic matroid of g: its ground set is the
+// edges of g, and a subset of edges is independent iff it forms a forest.
+func NewGraphic(g graph.Undirected) Matroid {
+	gs := EmptySet(graphicSetType)
+	endpoints := make(map[string][2]int64)
+
+	weighted, isWeighted := g.(graph.Weighted)
+
+	nodes := g.Nodes()
+	vertices := make([]int64, 0)
+	for nodes.Next() {
+		vertices = append(vertices, nodes.Node().ID())
+	}
+
+	for _, uid := range vertices {
+		to := g.From(uid)
+		for to.Next() {
+			vid := to.Node().ID()
+			if vid <= uid {
+				continue
+			}
+			w := 0.0
+			if isWeighted {
+				w, _ = weighted.Weight(uid, vid)
+			}
+			e := graphEdge{uid: uid, vid: vid, w: w}
+			gs.Add(e)
+			endpoints[e.Key()] = [2]int64{uid, vid}
+		}
+	}
+
+	return &graphicMatroid{groundSet: gs, endpoints: endpoints, vertices: vertices}
+}
+
+// NewCographic() returns the cographic matroid of g, i.e. the dual of its
+// graphic matroid.
+func NewCographic(g graph.Undirected) Matroid {
+	return Dual(NewGraphic(g))
+}
+
+func (gm *graphicMatroid) GroundSet() *Set {
+	return gm.groundSet
+}
+
+func (gm *graphicMatroid) Rank(s *Set) int {
+	uf := newUnionFind(gm.vertices)
+	components := len(gm.vertices)
+	for e := range s.Iter() {
+		ep := gm.endpoints[e.Key()]
+		if uf.union(ep[0], ep[1]) {
+			components--
+		}
+	}
+	return len(gm.vertices) - components
+}
+
+func (gm *graphicMatroid) Independent(s *Set) bool {
+	uf := newUnionFind(gm.vertices)
+	independent := true
+	for e := range s.Iter() {
+		ep := gm.endpoints[e.Key()]
+		if !uf.union(ep[0], ep[1]) {
+			independent = false
+		}
+	}
+	return independent
+}
@@ -0,0 +1,227 @@
+package matroid
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"sort"
+)
+
+// marshaledMatroid is the compact JSON schema Marshal/Unmarshal agree on:
+// the ground set and weights are enough to rebuild every Element, and the
+// list of bases is enough to answer Independent/Rank (a set is independent
+// iff it is a subset of some basis).
+type marshaledMatroid struct {
+	SetType   string             `json:"set_type"`
+	GroundSet []string           `json:"ground_set"`
+	Bases     [][]string         `json:"bases"`
+	Weights   map[string]float64 `json:"weights"`
+}
+
+// Marshal() encodes m as JSON: its ground set, every basis (found by brute
+// force, as Circuits and Flats already do in rich.go), and every element's
+// weight. Meant for matroids small enough for an exhaustive basis search to
+// be cheap.
+func Marshal(m Matroid) ([]byte, error) {
+	gs := m.GroundSet()
+	elems := gs.ToSlice()
+	rank := m.Rank(gs)
+	t := gs.GetType()
+
+	var bases [][]string
+	for mask := uint64(0); mask < uint64(1)<<len(elems); mask++ {
+		s := subsetOf(t, elems, mask)
+		if s.Cardinality() == rank && m.Independent(s) {
+			bases = append(bases, keysOf(s))
+		}
+	}
+
+	weights := make(map[string]float64, len(elems))
+	for _, e := range elems {
+		weights[e.Key()] = e.Weight()
+	}
+
+	return json.Marshal(marshaledMatroid{
+		SetType:   string(t),
+		GroundSet: keysOf(gs),
+		Bases:     bases,
+		Weights:   weights,
+	})
+}
+
+// Unmarshal() decodes data into a Matroid whose independent sets are
+// exactly the subsets of the decoded bases.
+func Unmarshal(data []byte) (Matroid, error) {
+	var doc marshaledMatroid
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	t := SetType(doc.SetType)
+	gs := EmptySet(t)
+	for _, k := range doc.GroundSet {
+		gs.Add(NewKeyedElement(k, doc.Weights[k]))
+	}
+
+	bases := make([]*Set, len(doc.Bases))
+	for i, keys := range doc.Bases {
+		b := EmptySet(t)
+		for _, k := range keys {
+			b.Add(NewKeyedElement(k, doc.Weights[k]))
+		}
+		bases[i] = b
+	}
+
+	return &basisMatroid{groundSet: gs, bases: bases}, nil
+}
+
+func keysOf(s *Set) []string {
+	keys := make([]string, 0, s.Cardinality())
+	s.Each(func(e Element) bool {
+		keys = append(keys, e.Key())
+		return true
+	})
+	return keys
+}
+
+// basisSetType is the SetType shared by every ground set built with
+// NewBasisMatroid.
+const basisSetType SetType = "basis"
+
+// basisMatroid is a matroid given directly by its list of bases: a set is
+// independent iff it is a subset of one of them, and its rank is the
+// largest overlap any basis has with it. It is what Unmarshal() and
+// NewBasisMatroid() build.
+type basisMatroid struct {
+	groundSet *Set
+	bases     []*Set
+}
+
+// NewBasisMatroid() returns the matroid whose ground set is groundSet and
+// whose independent sets are exactly the subsets of some element of bases.
+// It is the direct way to define a matroid with no simpler (linear,
+// graphic, ...) representation, such as the Vámos matroid.
+func NewBasisMatroid(groundSet []Element, bases [][]Element) Matroid {
+	gs := NewSet(basisSetType, groundSet...)
+	bs := make([]*Set, len(bases))
+	for i, b := range bases {
+		bs[i] = NewSet(basisSetType, b...)
+	}
+	return &basisMatroid{groundSet: gs, bases: bs}
+}
+
+func (bm *basisMatroid) GroundSet() *Set {
+	return bm.groundSet
+}
+
+func (bm *basisMatroid) Independent(s *Set) bool {
+	for _, b := range bm.bases {
+		if s.Subset(b) {
+			return true
+		}
+	}
+	return false
+}
+
+func (bm *basisMatroid) Rank(s *Set) int {
+	rank := 0
+	for _, b := range bm.bases {
+		if r := s.Intersect(b).Cardinality(); r > rank {
+			rank = r
+		}
+	}
+	return rank
+}
+
+// graphmlDocument mirrors the minimal subset of the GraphML schema
+// (http://graphml.graphdrawing.org/) needed to describe a weighted directed
+// graph: gonum has no graphml encoder (its graph/encoding/graphql package
+// is an unrelated GraphQL JSON format), so MarshalExchangeGraphGraphML
+// writes it directly via encoding/xml.
+type graphmlDocument struct {
+	XMLName xml.Name     `xml:"graphml"`
+	Xmlns   string       `xml:"xmlns,attr"`
+	Keys    []graphmlKey `xml:"key"`
+	Graph   graphmlGraph `xml:"graph"`
+}
+
+type graphmlKey struct {
+	ID       string `xml:"id,attr"`
+	For      string `xml:"for,attr"`
+	AttrName string `xml:"attr.name,attr"`
+	AttrType string `xml:"attr.type,attr"`
+}
+
+type graphmlGraph struct {
+	ID          string        `xml:"id,attr"`
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Nodes       []graphmlNode `xml:"node"`
+	Edges       []graphmlEdge `xml:"edge"`
+}
+
+type graphmlNode struct {
+	ID string `xml:"id,attr"`
+}
+
+type graphmlEdge struct {
+	Source string        `xml:"source,attr"`
+	Target string        `xml:"target,attr"`
+	Data   []graphmlData `xml:"data"`
+}
+
+type graphmlData struct {
+	Key   string  `xml:"key,attr"`
+	Value float64 `xml:",chardata"`
+}
+
+// MarshalExchangeGraphGraphML() builds m1 and m2's exchange digraph for the
+// common independent set s (see generateMatroidIntersectionBipartiteDigraph,
+// the core of Intersection's augmenting-path search) and encodes it as
+// GraphML, so the augmenting-path structure of an in-progress intersection
+// can be visualized in an external tool.
+func MarshalExchangeGraphGraphML(m1, m2 Matroid, s *Set) ([]byte, error) {
+	c, err := m1.GroundSet().Complement(s)
+	if err != nil {
+		return nil, err
+	}
+	d, k2n := generateMatroidIntersectionBipartiteDigraph(s, c, m1, m2, make(map[string]float64))
+
+	keyByID := make(map[int64]string, len(k2n))
+	for key, n := range k2n {
+		keyByID[n.id] = key
+	}
+
+	doc := graphmlDocument{
+		Xmlns: "http://graphml.graphdrawing.org/xmlns",
+		Keys:  []graphmlKey{{ID: "weight", For: "edge", AttrName: "weight", AttrType: "double"}},
+		Graph: graphmlGraph{ID: "exchange", EdgeDefault: "directed"},
+	}
+
+	nodes := d.Nodes()
+	for nodes.Next() {
+		doc.Graph.Nodes = append(doc.Graph.Nodes, graphmlNode{ID: keyByID[nodes.Node().ID()]})
+	}
+	sort.Slice(doc.Graph.Nodes, func(i, j int) bool { return doc.Graph.Nodes[i].ID < doc.Graph.Nodes[j].ID })
+
+	edges := d.Edges()
+	for edges.Next() {
+		e := edges.Edge()
+		w, _ := d.Weight(e.From().ID(), e.To().ID())
+		doc.Graph.Edges = append(doc.Graph.Edges, graphmlEdge{
+			Source: keyByID[e.From().ID()],
+			Target: keyByID[e.To().ID()],
+			Data:   []graphmlData{{Key: "weight", Value: w}},
+		})
+	}
+	sort.Slice(doc.Graph.Edges, func(i, j int) bool {
+		if doc.Graph.Edges[i].Source != doc.Graph.Edges[j].Source {
+			return doc.Graph.Edges[i].Source < doc.Graph.Edges[j].Source
+		}
+		return doc.Graph.Edges[i].Target < doc.Graph.Edges[j].Target
+	})
+
+	out, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), out...), nil
+}
@@ -0,0 +1,154 @@
+package matroid
+
+import (
+	"strconv"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// Field selects the arithmetic NewLinear() uses when computing rank.
+type Field int
+
+const (
+	// FieldRational treats matrix entries as rationals, via Gaussian
+	// elimination with floating-point pivoting (tolerance epsilon).
+	FieldRational Field = iota
+	// FieldBinary treats matrix entries as elements of GF(2): every entry
+	// is read mod 2, and elimination is done with XOR.
+	FieldBinary
+)
+
+// epsilon is the pivot tolerance used by FieldRational elimination.
+const epsilon = 1e-9
+
+// linearSetType is the SetType shared by every ground set built with
+// NewLinear.
+const linearSetType SetType = "linear"
+
+// linearMatroid is the linear (vector) matroid represented by the columns
+// of a matrix over the given field: a subset of columns is independent iff
+// they are linearly independent.
+type linearMatroid struct {
+	groundSet *Set
+	matrix    mat.Matrix
+	field     Field
+}
+
+// NewLinear() returns the linear matroid whose ground set is the column
+// indices of matrix, with independence determined by linear independence
+// over field.
+func NewLinear(matrix mat.Matrix, field Field) Matroid {
+	_, cols := matrix.Dims()
+	gs := EmptySet(linearSetType)
+	for j := 0; j < cols; j++ {
+		gs.Add(NewIntElement(j, 0))
+	}
+	return &linearMatroid{groundSet: gs, matrix: matrix, field: field}
+}
+
+func (lm *linearMatroid) GroundSet() *Set {
+	return lm.groundSet
+}
+
+func (lm *linearMatroid) Rank(s *Set) int {
+	cols := make([]int, 0, s.Cardinality())
+	for e := range s.Iter() {
+		j, err := strconv.Atoi(e.Key())
+		if err != nil {
+			panic(err)
+		}
+		cols = append(cols, j)
+	}
+	if lm.field == FieldBinary {
+		return rankBinary(lm.matrix, cols)
+	}
+	return rankRational(lm.matrix, cols)
+}
+
+func (lm *linearMatroid) Independent(s *Set) bool {
+	return lm.Rank(s) == s.Cardinality()
+}
+
+// rankRational() computes the rank of matrix restricted to cols over the
+// rationals via Gaussian elimination with partial pivoting.
+func rankRational(matrix mat.Matrix, cols []int) int {
+	rows, _ := matrix.Dims()
+	m := make([][]float64, rows)
+	for i := range m {
+		m[i] = make([]float64, len(cols))
+		for k, j := range cols {
+			m[i][k] = matrix.At(i, j)
+		}
+	}
+
+	rank, pivotRow := 0, 0
+	for col := 0; col < len(cols) && pivotRow < rows; col++ {
+		best := pivotRow
+		for r := pivotRow + 1; r < rows; r++ {
+			if abs(m[r][col]) > abs(m[best][col]) {
+				best = r
+			}
+		}
+		if abs(m[best][col]) < epsilon {
+			continue
+		}
+		m[pivotRow], m[best] = m[best], m[pivotRow]
+		for r := 0; r < rows; r++ {
+			if r == pivotRow {
+				continue
+			}
+			factor := m[r][col] / m[pivotRow][col]
+			for c := col; c < len(cols); c++ {
+				m[r][c] -= factor * m[pivotRow][c]
+			}
+		}
+		pivotRow++
+		rank++
+	}
+	return rank
+}
+
+func abs(f float64) float64 {
+	if f < 0 {
+		return -f
+	}
+	return f
+}
+
+// rankBinary() computes the rank of matrix restricted to cols over GF(2)
+// via Gaussian elimination with XOR row reduction.
+func rankBinary(matrix mat.Matrix, cols []int) int {
+	rows, _ := matrix.Dims()
+	m := make([][]byte, rows)
+	for i := range m {
+		m[i] = make([]byte, len(cols))
+		for k, j := range cols {
+			m[i][k] = byte(int64(matrix.At(i, j))) & 1
+		}
+	}
+
+	rank, pivotRow := 0, 0
+	for col := 0; col < len(cols) && pivotRow < rows; col++ {
+		sel := -1
+		for r := pivotRow; r < rows; r++ {
+			if m[r][col] == 1 {
+				sel = r
+				break
+			}
+		}
+		if sel == -1 {
+			continue
+		}
+		m[pivotRow], m[sel] = m[sel], m[pivotRow]
+		for r := 0; r < rows; r++ {
+			if r != pivotRow && m[r][col] == 1 {
+				for c := col; c < len(cols); c++ {
+					m[r][c] ^= m[pivotRow][c]
+				}
+			}
+		}
+		pivotRow++
+		rank++
+	}
+	return rank
+}
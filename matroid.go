@@ -2,12 +2,7 @@ package matroid
 
 import (
 	"fmt"
-	"math"
 	"sort"
-
-	"gonum.org/v1/gonum/graph"
-
-	"gonum.org/v1/gonum/graph/simple"
 )
 
 type Matroid interface {
@@ -37,40 +32,29 @@ func (s sorter) Swap(i, j int) {
 	s[i], s[j] = s[j], s[i]
 }
 
-type node struct {
-	id     int64
-	weight float64
-}
-
-func (n node) ID() int64 {
-	return n.id
-}
-
-type weightedEdge struct {
-	tail node
-	head node
-}
-
-func (w weightedEdge) From() graph.Node {
-	return w.tail
-}
-
-func (w weightedEdge) To() graph.Node {
-	return w.head
-}
-
-func (w weightedEdge) ReversedEdge() graph.Edge {
-	return weightedEdge{
-		tail: w.head,
-		head: w.tail,
-	}
-}
-
-func (w weightedEdge) Weight() float64 {
-	return w.head.weight - w.tail.weight
-}
-
-// Intersection() returns matroid intersection of input two matroids.
+// Intersection() returns a maximum-weight common independent set of m1 and
+// m2, found by repeatedly augmenting along a minimum-cost path of the
+// exchange digraph (Cassidy/Frank's weighted matroid intersection
+// algorithm): X1 = {y in C : S+y is independent in m1} is the source set,
+// X2 = {y in C : S+y is independent in m2} is the sink set, and each
+// iteration replaces S with S symmetric-difference V(P) for a minimum-cost
+// X1->X2 path P (ties broken by fewest arcs), where an arc's cost is the
+// weight gained by the element entering S along it (or lost by the element
+// it displaces). Node potentials, carried across iterations, keep the cost
+// search valid as S grows.
+//
+// Applying a path changes S's weight by exactly -cost, and the cost of the
+// cheapest augmenting path is known to be non-decreasing round over round,
+// so once it turns positive every later augmentation would only shrink
+// S's weight further: the loop stops there rather than applying it,
+// leaving S at the maximum weight reachable by augmentation (a zero-cost
+// path, which never increases weight either, is still applied, so a
+// common independent set of maximum cardinality among those is kept).
+// Unweighted callers (every Element.Weight() == 0) get the classic
+// max-cardinality common independent set, since every path then costs 0.
+//
+// The returned Set's total weight is not computed separately: sum its
+// elements' Weight(), or its Cardinality() for the unweighted case.
 func Intersection(m1, m2 Matroid) (*Set, error) {
 	if !(m1.GroundSet().GetType() == m2.GroundSet().GetType()) {
 		return nil, fmt.Errorf("incomparable setTypes: %s and %s",
@@ -82,58 +66,53 @@ func Intersection(m1, m2 Matroid) (*Set, error) {
 	gs := m1.GroundSet()
 	s := EmptySet(gs.GetType())
 
-	for e := range gs.Iter() {
-		s.Add(e)
-		if !(m1.Independent(s) && m2.Independent(s)) {
-			s.Remove(e)
+	potential := make(map[string]float64)
+	for {
+		c, err := gs.Complement(s)
+		if err != nil {
+			return nil, err
 		}
-	}
-
-	c, _ := gs.Complement(s)
-	d := generateMatroidIntersectionBipartiteDigraph(s, c, m1, m2)
-	return nil, nil
-}
 
-func generateMatroidIntersectionBipartiteDigraph(s, c *Set, m1, m2 Matroid) *simple.WeightedDirectedGraph {
-	k2n := getKeyToNodeMap(s, c)
-	d := simple.NewWeightedDirectedGraph(0, math.Inf(1))
-	for _, v := range k2n {
-		d.AddNode(v)
-	}
-	s0 := s.Clone()
-	for e := range s.Iter() {
-		for f := range c.Iter() {
-			s0.Swap(f, e)
-			if m1.Independent(s0) {
-				d.SetWeightedEdge(weightedEdge{tail: k2n[e.Key()], head: k2n[f.Key()]})
+		elemByKey := make(map[string]Element)
+		x1, x2 := EmptySet(gs.GetType()), EmptySet(gs.GetType())
+		s.Each(func(e Element) bool {
+			elemByKey[e.Key()] = e
+			return true
+		})
+		c.Each(func(e Element) bool {
+			elemByKey[e.Key()] = e
+			s.Add(e)
+			if m1.Independent(s) {
+				x1.Add(e)
 			}
-			if m2.Independent(s0) {
-				d.SetWeightedEdge(weightedEdge{tail: k2n[f.Key()], head: k2n[e.Key()]})
+			if m2.Independent(s) {
+				x2.Add(e)
 			}
-			s0.Swap(e, f)
+			s.Remove(e)
+			return true
+		})
+		if x1.Cardinality() == 0 || x2.Cardinality() == 0 {
+			break
 		}
-	}
-	return d
-}
 
-func getKeyToNodeMap(s, c *Set) map[string]node {
-	m := make(map[string]node)
-	var idx int64
-	for e := range s.Iter() {
-		m[e.Key()] = node{
-			id:     idx,
-			weight: e.Weight(),
+		d, k2n := generateMatroidIntersectionBipartiteDigraph(s, c, m1, m2, potential)
+		augPath, dist, cost, ok := shortestAugmentingPath(d, k2n, x1, x2)
+		if !ok || cost > 0 {
+			break
 		}
-		idx++
-	}
-	for e := range c.Iter() {
-		m[e.Key()] = node{
-			id:     idx,
-			weight: e.Weight(),
+		for key, dv := range dist {
+			potential[key] += dv
+		}
+		for _, key := range augPath {
+			e := elemByKey[key]
+			if s.Has(e) {
+				s.Remove(e)
+			} else {
+				s.Add(e)
+			}
 		}
-		idx++
 	}
-	return m
+	return s, nil
 }
 
 // GetBaseOf() returns an arbitrary base of input matroid.
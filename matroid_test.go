@@ -0,0 +1,76 @@
+package matroid
+
+import "testing"
+
+// TestIntersectionGraphicPartition checks Intersection against a
+// hand-verified instance: M(K4)'s graphic matroid (rank 3, forests)
+// intersected with a partition matroid capping the edges touching vertex 0
+// and the edges of the opposite triangle at 2 each. The spanning tree
+// {(0,1), (0,2), (1,3)} satisfies both, so the common independent set
+// should reach the full rank of 3.
+func TestIntersectionGraphicPartition(t *testing.T) {
+	g := completeGraphForTest(4)
+	gm := NewGraphic(g)
+	t_ := gm.GroundSet().GetType()
+
+	touchesZero := EmptySet(t_)
+	oppositeTriangle := EmptySet(t_)
+	for _, e := range gm.GroundSet().ToSlice() {
+		ge := e.(graphEdge)
+		if ge.uid == 0 {
+			touchesZero.Add(e)
+		} else {
+			oppositeTriangle.Add(e)
+		}
+	}
+
+	pm := NewPartition([]*Set{touchesZero, oppositeTriangle}, []int{2, 2})
+
+	common, err := Intersection(gm, pm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := common.Cardinality(), 3; got != want {
+		t.Fatalf("Intersection(M(K4), partition) cardinality = %d, want %d", got, want)
+	}
+	if !gm.Independent(common) || !pm.Independent(common) {
+		t.Fatal("Intersection result must be independent in both matroids")
+	}
+}
+
+// TestIntersectionMaximizesWeight checks Intersection against a bipartite
+// matching instance (two partition matroids over a common edge set) where
+// maximizing weight and maximizing cardinality disagree: edges a=(L1,R1)
+// w=10, b=(L1,R2) w=1, c=(L2,R1) w=1, each left and right endpoint capped
+// at 1. The max-weight common independent set is {a}=10; the
+// max-cardinality one is {b,c}=2. Intersection must return the former.
+func TestIntersectionMaximizesWeight(t *testing.T) {
+	st := SetType("bipartite-weight-test")
+	a := NewKeyedElement("a", 10)
+	b := NewKeyedElement("b", 1)
+	c := NewKeyedElement("c", 1)
+
+	l1 := NewSet(st, a, b)
+	l2 := NewSet(st, c)
+	r1 := NewSet(st, a, c)
+	r2 := NewSet(st, b)
+
+	m1 := NewPartition([]*Set{l1, l2}, []int{1, 1})
+	m2 := NewPartition([]*Set{r1, r2}, []int{1, 1})
+
+	common, err := Intersection(m1, m2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var weight float64
+	for _, e := range common.ToSlice() {
+		weight += e.Weight()
+	}
+	if weight != 10 {
+		t.Fatalf("Intersection weight = %v, want 10 (set: %v)", weight, common.ToSlice())
+	}
+	if common.Cardinality() != 1 || !common.Has(a) {
+		t.Fatalf("Intersection should return {a}, got %v", common.ToSlice())
+	}
+}
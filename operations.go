@@ -0,0 +1,339 @@
+package matroid
+
+import "fmt"
+
+// deletionMatroid is M\S: the restriction of m to GroundSet()-s. Since
+// independence of a set X (X subset of the smaller ground set) is
+// unaffected by removing elements outside X, every oracle but GroundSet()
+// simply delegates to m.
+type deletionMatroid struct {
+	groundSet *Set
+	m         Matroid
+}
+
+// Delete() returns M\S, the deletion of s from m.
+func Delete(m Matroid, s *Set) Matroid {
+	gs, err := m.GroundSet().Complement(s)
+	if err != nil {
+		panic(err)
+	}
+	return &deletionMatroid{groundSet: gs, m: m}
+}
+
+func (dm *deletionMatroid) GroundSet() *Set {
+	return dm.groundSet
+}
+
+func (dm *deletionMatroid) Rank(s *Set) int {
+	return dm.m.Rank(s)
+}
+
+func (dm *deletionMatroid) Independent(s *Set) bool {
+	return dm.m.Independent(s)
+}
+
+// contractionMatroid is M/T: its rank oracle is r'(X) = r(X∪T) - r(T), per
+// the standard definition of contraction.
+type contractionMatroid struct {
+	groundSet *Set
+	m         Matroid
+	t         *Set
+	rankT     int
+}
+
+// Contract() returns M/T, the contraction of t in m.
+func Contract(m Matroid, t *Set) Matroid {
+	gs, err := m.GroundSet().Complement(t)
+	if err != nil {
+		panic(err)
+	}
+	return &contractionMatroid{groundSet: gs, m: m, t: t.Clone(), rankT: m.Rank(t)}
+}
+
+func (cm *contractionMatroid) GroundSet() *Set {
+	return cm.groundSet
+}
+
+func (cm *contractionMatroid) Rank(s *Set) int {
+	union := s.Clone()
+	for e := range cm.t.Iter() {
+		union.Add(e)
+	}
+	return cm.m.Rank(union) - cm.rankT
+}
+
+func (cm *contractionMatroid) Independent(s *Set) bool {
+	return s.Cardinality() == cm.Rank(s)
+}
+
+// Minor() returns the minor M\del/con of m, i.e. m with con contracted and
+// del deleted. del and con must be disjoint subsets of m.GroundSet().
+func Minor(m Matroid, del, con *Set) Matroid {
+	return Delete(Contract(m, con), del)
+}
+
+// directSumMatroid is M1⊕...⊕Mk: independence and rank are decided
+// component-wise, each element routed back to the Mi that owns it.
+type directSumMatroid struct {
+	groundSet *Set
+	ms        []Matroid
+	matroidOf map[string]int
+}
+
+// DirectSum() returns the direct sum of ms. Their ground sets must be
+// pairwise disjoint; a subset of the result is independent iff its
+// restriction to every ms[i]'s ground set is independent in ms[i]. Like
+// NewPartition, the combined ground set reuses ms[0]'s SetType rather than
+// minting a new one, so the result can be intersected with other matroids
+// built over that same ground set.
+func DirectSum(ms ...Matroid) Matroid {
+	gs := EmptySet(ms[0].GroundSet().GetType())
+	matroidOf := make(map[string]int)
+	for i, m := range ms {
+		for e := range m.GroundSet().Iter() {
+			matroidOf[e.Key()] = i
+			gs.Add(e)
+		}
+	}
+	return &directSumMatroid{groundSet: gs, ms: ms, matroidOf: matroidOf}
+}
+
+func (dsm *directSumMatroid) GroundSet() *Set {
+	return dsm.groundSet
+}
+
+// split() partitions s by which ms[i] owns each element, rebuilding every
+// part with ms[i]'s own SetType so it can be passed straight to ms[i].
+func (dsm *directSumMatroid) split(s *Set) []*Set {
+	parts := make([]*Set, len(dsm.ms))
+	for i, m := range dsm.ms {
+		parts[i] = EmptySet(m.GroundSet().GetType())
+	}
+	for e := range s.Iter() {
+		parts[dsm.matroidOf[e.Key()]].Add(e)
+	}
+	return parts
+}
+
+func (dsm *directSumMatroid) Rank(s *Set) int {
+	rank := 0
+	for i, part := range dsm.split(s) {
+		rank += dsm.ms[i].Rank(part)
+	}
+	return rank
+}
+
+func (dsm *directSumMatroid) Independent(s *Set) bool {
+	for i, part := range dsm.split(s) {
+		if !dsm.ms[i].Independent(part) {
+			return false
+		}
+	}
+	return true
+}
+
+// unionSetType is the SetType of the disjoint-copy ground sets used
+// internally by unionMatroid.Rank.
+const unionSetType SetType = "union"
+
+// unionElement tags a copy of e with the index of the Mi it belongs to, so
+// k disjoint copies of a shared ground set can be direct-summed.
+type unionElement struct {
+	i int
+	e Element
+}
+
+func (u unionElement) Key() string {
+	return fmt.Sprintf("%d:%s", u.i, u.e.Key())
+}
+
+func (u unionElement) Weight() float64 {
+	return 0
+}
+
+// unionCopy is matroid i's copy of domain d, for the disjoint-copy
+// construction below: its ground set is {unionElement{i, e} : e in d}, and
+// its oracles untag a query before delegating to m.
+type unionCopy struct {
+	m         Matroid
+	groundSet *Set
+}
+
+func newUnionCopy(i int, m Matroid, d *Set) *unionCopy {
+	gs := EmptySet(unionSetType)
+	for e := range d.Iter() {
+		gs.Add(unionElement{i: i, e: e})
+	}
+	return &unionCopy{m: m, groundSet: gs}
+}
+
+func (uc *unionCopy) GroundSet() *Set {
+	return uc.groundSet
+}
+
+func (uc *unionCopy) untag(s *Set) *Set {
+	orig := EmptySet(uc.m.GroundSet().GetType())
+	for e := range s.Iter() {
+		orig.Add(e.(unionElement).e)
+	}
+	return orig
+}
+
+func (uc *unionCopy) Rank(s *Set) int {
+	return uc.m.Rank(uc.untag(s))
+}
+
+func (uc *unionCopy) Independent(s *Set) bool {
+	return uc.m.Independent(uc.untag(s))
+}
+
+// unionMatroid is M1∨...∨Mk (the Nash-Williams/Edmonds matroid union): a
+// subset is independent iff it partitions into parts, one per Mi, each
+// independent in that Mi.
+type unionMatroid struct {
+	groundSet *Set
+	ms        []Matroid
+}
+
+// Union() returns the matroid union of ms, which must share a common
+// ground set.
+func Union(ms ...Matroid) Matroid {
+	return &unionMatroid{groundSet: ms[0].GroundSet(), ms: ms}
+}
+
+func (um *unionMatroid) GroundSet() *Set {
+	return um.groundSet
+}
+
+// Rank() computes r(X) per Edmonds: build k disjoint copies of X (one per
+// Mi, via unionCopy), direct-sum them into N, and pair N with a partition
+// matroid P that caps every element's copies at 1. A maximum common
+// independent set of N and P, found by the existing Intersection()
+// algorithm, selects at most one copy of each element of X and is
+// independent in its owning Mi, i.e. it is exactly a maximum independent
+// subset of X in the union matroid.
+func (um *unionMatroid) Rank(s *Set) int {
+	taggedMs := make([]Matroid, len(um.ms))
+	for i, m := range um.ms {
+		taggedMs[i] = newUnionCopy(i, m, s)
+	}
+	n := DirectSum(taggedMs...)
+
+	groupOf := make(map[string][]Element)
+	for e := range s.Iter() {
+		for i := range um.ms {
+			groupOf[e.Key()] = append(groupOf[e.Key()], unionElement{i: i, e: e})
+		}
+	}
+	parts := make([]*Set, 0, len(groupOf))
+	caps := make([]int, 0, len(groupOf))
+	for _, copies := range groupOf {
+		parts = append(parts, NewSet(unionSetType, copies...))
+		caps = append(caps, 1)
+	}
+	p := NewPartition(parts, caps)
+
+	common, err := Intersection(n, p)
+	if err != nil {
+		panic(err)
+	}
+	return common.Cardinality()
+}
+
+func (um *unionMatroid) Independent(s *Set) bool {
+	return um.Rank(s) == s.Cardinality()
+}
+
+// HasMinor() returns true if m has a minor isomorphic to n, tested by
+// enumerating every way to remove |m.GroundSet())-|n.GroundSet()| elements
+// of m (as a deletion/contraction split of the removed elements) and
+// brute-force checking the resulting minor for isomorphism to n. Meant for
+// testing small patterns (U(2,4), the Fano matroid F7, M(K5), ...) against
+// matroids small enough for this exhaustive search to terminate quickly.
+func HasMinor(m, n Matroid) bool {
+	k := n.GroundSet().Cardinality()
+	elems := m.GroundSet().ToSlice()
+	t := m.GroundSet().GetType()
+	total := len(elems)
+	if k > total {
+		return false
+	}
+
+	for mask := uint64(0); mask < uint64(1)<<total; mask++ {
+		kept := subsetOf(t, elems, mask)
+		if kept.Cardinality() != k {
+			continue
+		}
+		removed, err := m.GroundSet().Complement(kept)
+		if err != nil {
+			panic(err)
+		}
+		removedElems := removed.ToSlice()
+		for conMask := uint64(0); conMask < uint64(1)<<len(removedElems); conMask++ {
+			con := subsetOf(t, removedElems, conMask)
+			del, err := removed.Complement(con)
+			if err != nil {
+				panic(err)
+			}
+			if isomorphic(Minor(m, del, con), n) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isomorphic() returns true if there is a bijection between a and b's
+// ground sets under which independence agrees on every subset. It is a
+// brute-force permutation search, only meant for the small patterns
+// HasMinor tests against.
+func isomorphic(a, b Matroid) bool {
+	ae := a.GroundSet().ToSlice()
+	be := b.GroundSet().ToSlice()
+	if len(ae) != len(be) {
+		return false
+	}
+	perm := make([]int, len(be))
+	used := make([]bool, len(be))
+	return searchIsomorphism(a, b, ae, be, perm, used, 0)
+}
+
+func searchIsomorphism(a, b Matroid, ae, be []Element, perm []int, used []bool, pos int) bool {
+	if pos == len(ae) {
+		return matroidsAgree(a, b, ae, be, perm)
+	}
+	for i, taken := range used {
+		if taken {
+			continue
+		}
+		used[i] = true
+		perm[pos] = i
+		if searchIsomorphism(a, b, ae, be, perm, used, pos+1) {
+			used[i] = false
+			return true
+		}
+		used[i] = false
+	}
+	return false
+}
+
+// matroidsAgree() returns true if every subset of ae is independent in a
+// iff its image under perm (ae[j] -> be[perm[j]]) is independent in b.
+func matroidsAgree(a, b Matroid, ae, be []Element, perm []int) bool {
+	n := len(ae)
+	at := a.GroundSet().GetType()
+	bt := b.GroundSet().GetType()
+	for mask := uint64(0); mask < uint64(1)<<n; mask++ {
+		as := subsetOf(at, ae, mask)
+		bs := EmptySet(bt)
+		for i := 0; i < n; i++ {
+			if mask&(1<<uint(i)) != 0 {
+				bs.Add(be[perm[i]])
+			}
+		}
+		if a.Independent(as) != b.Independent(bs) {
+			return false
+		}
+	}
+	return true
+}
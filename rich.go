@@ -0,0 +1,279 @@
+package matroid
+
+import "sort"
+
+// RichMatroid extends Matroid with the standard combinatorial oracles built
+// on top of Rank: circuits, closure, flats, and connectivity. Use
+// NewRichMatroid() to get a default implementation for any Matroid.
+//
+// These oracles enumerate subsets of the ground set and are meant for
+// moderately small matroids (tens of elements); they are not a substitute
+// for a specialized oracle on a matroid that has one.
+type RichMatroid interface {
+	Matroid
+	// Circuits() returns every circuit (minimal dependent set) of the matroid.
+	Circuits() []*Set
+	// FundamentalCircuit() returns the unique circuit in base+e, where base
+	// is a base of the matroid and e is not in base.
+	FundamentalCircuit(base *Set, e Element) *Set
+	// Closure() returns {x in GroundSet : Rank(s+x) == Rank(s)}.
+	Closure(s *Set) *Set
+	// Flats() returns every flat (closed set) of the given rank.
+	Flats(rank int) []*Set
+	// Loops() returns the rank-0 elements of the matroid.
+	Loops() *Set
+	// Coloops() returns the elements present in every base.
+	Coloops() *Set
+	// IsConnected() returns true if the matroid has no 1-separation, i.e.
+	// it is not the direct sum of two nonempty matroids.
+	IsConnected() bool
+	// Is3Connected() returns true if the matroid has neither a
+	// 1-separation nor a 2-separation.
+	Is3Connected() bool
+	// Components() returns the matroid's connected components.
+	Components() []*Set
+}
+
+// NewRichMatroid() wraps m with default Circuits/Closure/Flats/connectivity
+// oracles built on top of m.Rank.
+func NewRichMatroid(m Matroid) RichMatroid {
+	return &richMatroid{Matroid: m}
+}
+
+type richMatroid struct {
+	Matroid
+}
+
+// connectivity() is lambda(X) = rank(X) + rank(E-X) - rank(E), the standard
+// matroid connectivity function used to test for k-separations.
+func (rm *richMatroid) connectivity(x *Set) int {
+	e := rm.GroundSet()
+	complement, err := e.Complement(x)
+	if err != nil {
+		panic(err)
+	}
+	return rm.Rank(x) + rm.Rank(complement) - rm.Rank(e)
+}
+
+func (rm *richMatroid) Closure(s *Set) *Set {
+	rank := rm.Rank(s)
+	cl := EmptySet(s.GetType())
+	for e := range s.Iter() {
+		cl.Add(e)
+	}
+	for e := range rm.GroundSet().Iter() {
+		if cl.Has(e) {
+			continue
+		}
+		s0 := s.Clone()
+		s0.Add(e)
+		if rm.Rank(s0) == rank {
+			cl.Add(e)
+		}
+	}
+	return cl
+}
+
+func (rm *richMatroid) Loops() *Set {
+	loops := EmptySet(rm.GroundSet().GetType())
+	for e := range rm.GroundSet().Iter() {
+		if rm.Rank(NewSet(rm.GroundSet().GetType(), e)) == 0 {
+			loops.Add(e)
+		}
+	}
+	return loops
+}
+
+func (rm *richMatroid) Coloops() *Set {
+	full := rm.GroundSet()
+	fullRank := rm.Rank(full)
+	coloops := EmptySet(full.GetType())
+	for e := range full.Iter() {
+		without := full.Clone()
+		without.Remove(e)
+		if rm.Rank(without) < fullRank {
+			coloops.Add(e)
+		}
+	}
+	return coloops
+}
+
+// FundamentalCircuit() finds the circuit of base+e by starting from it and
+// greedily dropping elements of base whose removal leaves it dependent,
+// leaving exactly the minimal dependent set containing e.
+func (rm *richMatroid) FundamentalCircuit(base *Set, e Element) *Set {
+	circuit := base.Clone()
+	circuit.Add(e)
+	for x := range base.Iter() {
+		without := circuit.Clone()
+		without.Remove(x)
+		if !rm.Independent(without) {
+			circuit = without
+		}
+	}
+	return circuit
+}
+
+func (rm *richMatroid) Circuits() []*Set {
+	elems := rm.GroundSet().ToSlice()
+	n := len(elems)
+	t := rm.GroundSet().GetType()
+
+	var circuits []*Set
+	for mask := uint64(1); mask < uint64(1)<<n; mask++ {
+		s := subsetOf(t, elems, mask)
+		if rm.Independent(s) {
+			continue
+		}
+		if containsKnownCircuit(s, circuits) {
+			continue
+		}
+		if isCircuit(rm, s) {
+			circuits = append(circuits, s)
+		}
+	}
+	return circuits
+}
+
+// isCircuit() relies on the matroid circuit axiom that a dependent set is
+// minimal (a circuit) iff every single-element deletion of it is
+// independent.
+func isCircuit(m Matroid, s *Set) bool {
+	for e := range s.Iter() {
+		without := s.Clone()
+		without.Remove(e)
+		if !m.Independent(without) {
+			return false
+		}
+	}
+	return true
+}
+
+func containsKnownCircuit(s *Set, circuits []*Set) bool {
+	for _, c := range circuits {
+		if c.Subset(s) {
+			return true
+		}
+	}
+	return false
+}
+
+func subsetOf(t SetType, elems []Element, mask uint64) *Set {
+	s := EmptySet(t)
+	for i, e := range elems {
+		if mask&(1<<uint(i)) != 0 {
+			s.Add(e)
+		}
+	}
+	return s
+}
+
+func (rm *richMatroid) Flats(rank int) []*Set {
+	elems := rm.GroundSet().ToSlice()
+	n := len(elems)
+	t := rm.GroundSet().GetType()
+
+	seen := make(map[string]bool)
+	var flats []*Set
+	for mask := uint64(0); mask < uint64(1)<<n; mask++ {
+		s := subsetOf(t, elems, mask)
+		if s.Cardinality() != rank || !rm.Independent(s) {
+			continue
+		}
+		cl := rm.Closure(s)
+		key := flatKey(cl)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		flats = append(flats, cl)
+	}
+	return flats
+}
+
+func flatKey(s *Set) string {
+	keys := make([]string, 0, s.Cardinality())
+	for e := range s.Iter() {
+		keys = append(keys, e.Key())
+	}
+	sort.Strings(keys)
+	key := ""
+	for _, k := range keys {
+		key += k + ","
+	}
+	return key
+}
+
+func (rm *richMatroid) IsConnected() bool {
+	return len(rm.separations(1)) == 0
+}
+
+func (rm *richMatroid) Is3Connected() bool {
+	return rm.IsConnected() && len(rm.separations(2)) == 0
+}
+
+// separations() returns every nonempty X, |X| <= |E-X|, that is a
+// k-separation: min(|X|, |E-X|) >= k and connectivity(X) < k.
+func (rm *richMatroid) separations(k int) []*Set {
+	elems := rm.GroundSet().ToSlice()
+	n := len(elems)
+	t := rm.GroundSet().GetType()
+
+	var seps []*Set
+	for mask := uint64(1); mask < uint64(1)<<(n-1); mask++ {
+		s := subsetOf(t, elems, mask)
+		other := n - s.Cardinality()
+		if s.Cardinality() < k || other < k {
+			continue
+		}
+		if rm.connectivity(s) < k {
+			seps = append(seps, s)
+		}
+	}
+	return seps
+}
+
+// Components() returns the matroid's connected components, found by
+// union-find over the ground set's elements, merging every pair of
+// elements that share a circuit.
+func (rm *richMatroid) Components() []*Set {
+	elems := rm.GroundSet().ToSlice()
+	ids := make([]int64, len(elems))
+	keyByID := make(map[int64]string, len(elems))
+	idByKey := make(map[string]int64, len(elems))
+	for i, e := range elems {
+		ids[i] = int64(i)
+		keyByID[int64(i)] = e.Key()
+		idByKey[e.Key()] = int64(i)
+	}
+
+	uf := newUnionFind(ids)
+	for _, c := range rm.Circuits() {
+		var first int64
+		firstSet := false
+		for e := range c.Iter() {
+			id := idByKey[e.Key()]
+			if !firstSet {
+				first, firstSet = id, true
+				continue
+			}
+			uf.union(first, id)
+		}
+	}
+
+	groups := make(map[int64]*Set)
+	t := rm.GroundSet().GetType()
+	for _, e := range elems {
+		root := uf.find(idByKey[e.Key()])
+		if groups[root] == nil {
+			groups[root] = EmptySet(t)
+		}
+		groups[root].Add(e)
+	}
+
+	components := make([]*Set, 0, len(groups))
+	for _, s := range groups {
+		components = append(components, s)
+	}
+	return components
+}
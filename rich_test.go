@@ -0,0 +1,70 @@
+package matroid
+
+import (
+	"testing"
+
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+// completeGraphForTest returns Kn, the undirected complete graph on n
+// vertices 0..n-1, for exercising NewGraphic in tests.
+func completeGraphForTest(n int) *simple.UndirectedGraph {
+	g := simple.NewUndirectedGraph()
+	for i := 0; i < n; i++ {
+		g.AddNode(simple.Node(i))
+	}
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			g.SetEdge(simple.Edge{F: simple.Node(i), T: simple.Node(j)})
+		}
+	}
+	return g
+}
+
+func TestFundamentalCircuit(t *testing.T) {
+	t.Run("uniform", func(t *testing.T) {
+		u := NewUniform(3, 2)
+		rm := NewRichMatroid(u)
+		base := NewSet(u.GroundSet().GetType(), NewIntElement(0, 0), NewIntElement(1, 0))
+		fc := rm.FundamentalCircuit(base, NewIntElement(2, 0))
+
+		if fc.Cardinality() != 3 {
+			t.Fatalf("expected circuit of cardinality 3, got %d", fc.Cardinality())
+		}
+		if u.Independent(fc) {
+			t.Fatal("fundamental circuit must be dependent")
+		}
+		for _, e := range fc.ToSlice() {
+			without := fc.Clone()
+			without.Remove(e)
+			if !u.Independent(without) {
+				t.Fatalf("removing %s should leave an independent set", e.Key())
+			}
+		}
+	})
+
+	t.Run("graphic", func(t *testing.T) {
+		g := completeGraphForTest(4)
+		gm := NewGraphic(g)
+		rm := NewRichMatroid(gm)
+
+		base := GetBaseOf(gm)
+		if base.Cardinality() != 3 {
+			t.Fatalf("expected a spanning tree of K4 to have 3 edges, got %d", base.Cardinality())
+		}
+
+		c, err := gm.GroundSet().Complement(base)
+		if err != nil {
+			t.Fatal(err)
+		}
+		e := c.ToSlice()[0]
+		fc := rm.FundamentalCircuit(base, e)
+
+		if gm.Independent(fc) {
+			t.Fatal("fundamental circuit of base+e must be dependent")
+		}
+		if !fc.Has(e) {
+			t.Fatal("fundamental circuit must contain e")
+		}
+	})
+}
@@ -0,0 +1,278 @@
+package matroid
+
+import (
+	"fmt"
+	"sort"
+)
+
+// SetType identifies the concrete Element implementation backing a Set, so
+// that two Sets (and the Matroids built on them) can be checked for
+// compatibility before being combined, e.g. in Intersection().
+type SetType string
+
+// GenericSet[E] is an unordered, duplicate-free collection of E, backed by
+// a slice kept sorted by Key() (so iteration order is deterministic) plus a
+// Key()->index map (so Has/Add/Remove/Toggle don't have to scan). Set, this
+// package's ground-set type, is GenericSet[Element]; build a
+// GenericSet[E] directly only when a collection of one concrete Element
+// implementation is wanted.
+type GenericSet[E Element] struct {
+	t     SetType
+	elems []E
+	index map[string]int
+}
+
+// Set is an unordered collection of distinct Elements, keyed by Element.Key().
+type Set = GenericSet[Element]
+
+// EmptySet() returns an empty Set of the given SetType.
+func EmptySet(t SetType) *Set {
+	return EmptyGenericSet[Element](t)
+}
+
+// EmptyGenericSet() returns an empty GenericSet[E] of the given SetType.
+func EmptyGenericSet[E Element](t SetType) *GenericSet[E] {
+	return &GenericSet[E]{t: t, index: make(map[string]int)}
+}
+
+// NewSet() returns a Set of the given SetType containing es.
+func NewSet(t SetType, es ...Element) *Set {
+	return NewGenericSet(t, es...)
+}
+
+// NewGenericSet() returns a GenericSet[E] of the given SetType containing es.
+func NewGenericSet[E Element](t SetType, es ...E) *GenericSet[E] {
+	s := EmptyGenericSet[E](t)
+	for _, e := range es {
+		s.Add(e)
+	}
+	return s
+}
+
+// GetType() returns the SetType of s.
+func (s *GenericSet[E]) GetType() SetType {
+	return s.t
+}
+
+// Add() adds e to s. It is a no-op if e is already present.
+func (s *GenericSet[E]) Add(e E) {
+	if _, ok := s.index[e.Key()]; ok {
+		return
+	}
+	i := sort.Search(len(s.elems), func(i int) bool { return s.elems[i].Key() >= e.Key() })
+	s.elems = append(s.elems, e)
+	copy(s.elems[i+1:], s.elems[i:])
+	s.elems[i] = e
+	s.reindexFrom(i)
+}
+
+// Remove() removes e from s. It is a no-op if e is not present.
+func (s *GenericSet[E]) Remove(e E) {
+	i, ok := s.index[e.Key()]
+	if !ok {
+		return
+	}
+	copy(s.elems[i:], s.elems[i+1:])
+	s.elems = s.elems[:len(s.elems)-1]
+	delete(s.index, e.Key())
+	s.reindexFrom(i)
+}
+
+// reindexFrom() rebuilds the Key()->index entries of every element from i
+// onward, after an Add or Remove has shifted their positions.
+func (s *GenericSet[E]) reindexFrom(i int) {
+	for ; i < len(s.elems); i++ {
+		s.index[s.elems[i].Key()] = i
+	}
+}
+
+// Has() returns true if e is present in s.
+func (s *GenericSet[E]) Has(e E) bool {
+	_, ok := s.index[e.Key()]
+	return ok
+}
+
+// Toggle() removes e from s if present, or adds it if absent.
+func (s *GenericSet[E]) Toggle(e E) {
+	if s.Has(e) {
+		s.Remove(e)
+	} else {
+		s.Add(e)
+	}
+}
+
+// Swap() removes old and adds new in a single step.
+//
+// Deprecated: call Toggle(old) followed by Toggle(new) instead. Swap
+// assumes old is present and new is absent; Toggle makes no such
+// assumption and is what Intersection's exchange loop now uses.
+func (s *GenericSet[E]) Swap(new, old E) {
+	s.Remove(old)
+	s.Add(new)
+}
+
+// Cardinality() returns the number of elements in s.
+func (s *GenericSet[E]) Cardinality() int {
+	return len(s.elems)
+}
+
+// Clone() returns a shallow copy of s.
+func (s *GenericSet[E]) Clone() *GenericSet[E] {
+	c := EmptyGenericSet[E](s.t)
+	c.elems = append([]E(nil), s.elems...)
+	c.reindexFrom(0)
+	return c
+}
+
+// Equal() returns true if s and o contain the same elements.
+func (s *GenericSet[E]) Equal(o *GenericSet[E]) bool {
+	if s.t != o.t || len(s.elems) != len(o.elems) {
+		return false
+	}
+	for i, e := range s.elems {
+		if o.elems[i].Key() != e.Key() {
+			return false
+		}
+	}
+	return true
+}
+
+// Subset() returns true if every element of s is present in o.
+func (s *GenericSet[E]) Subset(o *GenericSet[E]) bool {
+	if s.t != o.t || len(s.elems) > len(o.elems) {
+		return false
+	}
+	for _, e := range s.elems {
+		if !o.Has(e) {
+			return false
+		}
+	}
+	return true
+}
+
+// mustMatch() panics if s and o are of different SetTypes. The bulk set-
+// algebra methods use it, since combining two incomparable ground sets is a
+// programming error rather than something callers are expected to recover
+// from.
+func (s *GenericSet[E]) mustMatch(o *GenericSet[E]) {
+	if s.t != o.t {
+		panic(fmt.Sprintf("incomparable setTypes: %s and %s", s.t, o.t))
+	}
+}
+
+// Union() returns the elements present in s or o.
+func (s *GenericSet[E]) Union(o *GenericSet[E]) *GenericSet[E] {
+	s.mustMatch(o)
+	r := s.Clone()
+	for _, e := range o.elems {
+		r.Add(e)
+	}
+	return r
+}
+
+// Intersect() returns the elements present in both s and o.
+func (s *GenericSet[E]) Intersect(o *GenericSet[E]) *GenericSet[E] {
+	s.mustMatch(o)
+	r := EmptyGenericSet[E](s.t)
+	for _, e := range s.elems {
+		if o.Has(e) {
+			r.Add(e)
+		}
+	}
+	return r
+}
+
+// Difference() returns the elements of s that are not in o.
+func (s *GenericSet[E]) Difference(o *GenericSet[E]) *GenericSet[E] {
+	s.mustMatch(o)
+	r := EmptyGenericSet[E](s.t)
+	for _, e := range s.elems {
+		if !o.Has(e) {
+			r.Add(e)
+		}
+	}
+	return r
+}
+
+// SymmetricDifference() returns the elements present in exactly one of s
+// and o.
+func (s *GenericSet[E]) SymmetricDifference(o *GenericSet[E]) *GenericSet[E] {
+	s.mustMatch(o)
+	r := s.Difference(o)
+	for _, e := range o.elems {
+		if !s.Has(e) {
+			r.Add(e)
+		}
+	}
+	return r
+}
+
+// Complement() returns the elements of s that are not in sub. sub must be of
+// the same SetType as s.
+//
+// Deprecated: use Difference, which panics on a SetType mismatch instead of
+// returning an error; every call site in this package already treats a
+// mismatch as a programming error.
+func (s *GenericSet[E]) Complement(sub *GenericSet[E]) (*GenericSet[E], error) {
+	if s.t != sub.t {
+		return nil, fmt.Errorf("incomparable setTypes: %s and %s", s.t, sub.t)
+	}
+	return s.Difference(sub), nil
+}
+
+// ToSlice() returns the elements of s as a slice, in sorted Key() order.
+func (s *GenericSet[E]) ToSlice() []E {
+	return append([]E(nil), s.elems...)
+}
+
+// Each() calls f with every element of s, in sorted Key() order, stopping
+// early if f returns false. It returns false if it was stopped early.
+func (s *GenericSet[E]) Each(f func(E) bool) bool {
+	for _, e := range s.elems {
+		if !f(e) {
+			return false
+		}
+	}
+	return true
+}
+
+// Iterator is an allocation-free cursor over a GenericSet[E]'s elements, in
+// sorted Key() order. Its zero value is not usable; get one from
+// GenericSet[E].NewIterator().
+type Iterator[E Element] struct {
+	elems []E
+	i     int
+}
+
+// NewIterator() returns an Iterator over s's elements.
+func (s *GenericSet[E]) NewIterator() Iterator[E] {
+	return Iterator[E]{elems: s.elems}
+}
+
+// Next() advances the iterator and reports whether a Value is available.
+func (it *Iterator[E]) Next() bool {
+	it.i++
+	return it.i <= len(it.elems)
+}
+
+// Value() returns the element at the iterator's current position. Only
+// valid after a call to Next() that returned true.
+func (it *Iterator[E]) Value() E {
+	return it.elems[it.i-1]
+}
+
+// Iter() returns a channel that yields every element of s exactly once, in
+// sorted Key() order.
+//
+// Deprecated: ranging over a channel allocates a goroutine per call; use
+// Each or an Iterator instead, as Intersection's exchange loop now does.
+func (s *GenericSet[E]) Iter() <-chan E {
+	ch := make(chan E)
+	go func() {
+		defer close(ch)
+		for _, e := range s.elems {
+			ch <- e
+		}
+	}()
+	return ch
+}
@@ -0,0 +1,57 @@
+package matroid
+
+// transversalSetType is the SetType shared by every ground set built with
+// NewTransversal.
+const transversalSetType SetType = "transversal"
+
+// transversalMatroid is the transversal matroid of a bipartite graph: its
+// ground set is the left vertices, and a subset is independent iff it can
+// be matched into the right vertices.
+type transversalMatroid struct {
+	groundSet *Set
+	adj       map[string][]string
+}
+
+// NewTransversal() returns the transversal matroid of a bipartite graph
+// whose left side is left and whose edges are given by adj, mapping each
+// left Element's Key() to the right-side labels it connects to. A subset
+// of left is independent iff it has a matching into the right side,
+// computed via augmenting-path bipartite matching.
+func NewTransversal(left []Element, adj map[string][]string) Matroid {
+	return &transversalMatroid{groundSet: NewSet(transversalSetType, left...), adj: adj}
+}
+
+func (tm *transversalMatroid) GroundSet() *Set {
+	return tm.groundSet
+}
+
+func (tm *transversalMatroid) Rank(s *Set) int {
+	matchRight := make(map[string]string)
+	rank := 0
+	for e := range s.Iter() {
+		if tm.augment(e.Key(), make(map[string]bool), matchRight) {
+			rank++
+		}
+	}
+	return rank
+}
+
+func (tm *transversalMatroid) Independent(s *Set) bool {
+	return tm.Rank(s) == s.Cardinality()
+}
+
+// augment() looks for an augmenting path out of left in the current
+// matching matchRight, extending it in place on success (Kuhn's algorithm).
+func (tm *transversalMatroid) augment(left string, visited map[string]bool, matchRight map[string]string) bool {
+	for _, right := range tm.adj[left] {
+		if visited[right] {
+			continue
+		}
+		visited[right] = true
+		if cur, ok := matchRight[right]; !ok || tm.augment(cur, visited, matchRight) {
+			matchRight[right] = left
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,36 @@
+package matroid
+
+// uniformSetType is the SetType shared by every ground set built with
+// NewUniform.
+const uniformSetType SetType = "uniform"
+
+// uniformMatroid is U(k,n): every subset of size at most k is independent.
+type uniformMatroid struct {
+	groundSet *Set
+	k         int
+}
+
+// NewUniform() returns the uniform matroid U(k,n): ground set {0, ..., n-1}
+// with every subset of size at most k independent.
+func NewUniform(n, k int) Matroid {
+	gs := EmptySet(uniformSetType)
+	for i := 0; i < n; i++ {
+		gs.Add(NewIntElement(i, 0))
+	}
+	return &uniformMatroid{groundSet: gs, k: k}
+}
+
+func (u *uniformMatroid) GroundSet() *Set {
+	return u.groundSet
+}
+
+func (u *uniformMatroid) Rank(s *Set) int {
+	if s.Cardinality() < u.k {
+		return s.Cardinality()
+	}
+	return u.k
+}
+
+func (u *uniformMatroid) Independent(s *Set) bool {
+	return s.Cardinality() <= u.k
+}
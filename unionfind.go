@@ -0,0 +1,42 @@
+package matroid
+
+// unionFind is a disjoint-set structure over int64 node IDs, used by the
+// graphic matroid's Rank/Independent to test acyclicity in close to linear
+// time instead of brute-force cycle detection.
+type unionFind struct {
+	parent map[int64]int64
+	rank   map[int64]int
+}
+
+func newUnionFind(ids []int64) *unionFind {
+	uf := &unionFind{parent: make(map[int64]int64, len(ids)), rank: make(map[int64]int, len(ids))}
+	for _, id := range ids {
+		uf.parent[id] = id
+	}
+	return uf
+}
+
+func (uf *unionFind) find(x int64) int64 {
+	for uf.parent[x] != x {
+		uf.parent[x] = uf.parent[uf.parent[x]]
+		x = uf.parent[x]
+	}
+	return x
+}
+
+// union() merges the sets containing x and y, returning false if they were
+// already in the same set (i.e. joining them would close a cycle).
+func (uf *unionFind) union(x, y int64) bool {
+	rx, ry := uf.find(x), uf.find(y)
+	if rx == ry {
+		return false
+	}
+	if uf.rank[rx] < uf.rank[ry] {
+		rx, ry = ry, rx
+	}
+	uf.parent[ry] = rx
+	if uf.rank[rx] == uf.rank[ry] {
+		uf.rank[rx]++
+	}
+	return true
+}